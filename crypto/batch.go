@@ -0,0 +1,19 @@
+package crypto
+
+// BatchVerifier is an interface for a mathematical identity that lets us
+// verify a batch of ed25519 (or other) signatures in a single operation,
+// which is considerably faster than verifying each signature individually.
+//
+// Implementations are free to fall back to serial verification if the
+// underlying scheme offers no speedup, or if a batch fails and the caller
+// needs to know which entries are invalid.
+type BatchVerifier interface {
+	// Add appends an entry into the BatchVerifier.
+	Add(key PubKey, message, signature []byte) error
+
+	// Verify verifies all the entries in the BatchVerifier. It returns
+	// a single bool indicating whether every signature in the batch is
+	// valid, and a slice indicating the validity of each individual
+	// entry, in the order in which they were added.
+	Verify() (ok bool, valid []bool)
+}
@@ -0,0 +1,151 @@
+package ed25519
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"errors"
+
+	"filippo.io/edwards25519"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+var _ crypto.BatchVerifier = (*BatchVerifier)(nil)
+
+// entry holds everything needed to verify (or re-verify, on batch failure)
+// a single signature.
+type entry struct {
+	R *edwards25519.Point
+	s *edwards25519.Scalar
+	A *edwards25519.Point
+	k *edwards25519.Scalar
+	z *edwards25519.Scalar
+
+	pubKey PubKey
+	msg    []byte
+	sig    []byte
+}
+
+// BatchVerifier implements batch verification for ed25519, backed by the
+// standard Ed25519 batch equation:
+//
+//	[-sum(z_i * s_i)]B + sum(z_i * R_i) + sum((z_i * k_i mod L) * A_i) == 0
+//
+// where z_i are random 128-bit scalars and k_i = H(R_i || A_i || M_i).
+//
+// On failure, Verify falls back to verifying each entry individually so the
+// caller can tell which signatures were bad.
+type BatchVerifier struct {
+	entries []entry
+}
+
+// NewBatchVerifier returns an empty BatchVerifier ready to have entries
+// added to it via Add.
+func NewBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// Add appends an (pubKey, message, signature) tuple to the batch. It
+// returns an error if pubKey is not an ed25519.PubKey, or if sig/pubKey
+// are malformed, since those can be rejected up front without affecting
+// the validity of the rest of the batch.
+func (v *BatchVerifier) Add(pubKey crypto.PubKey, msg, sig []byte) error {
+	ed25519PubKey, ok := pubKey.(PubKey)
+	if !ok {
+		return errors.New("ed25519: pubkey is not ed25519")
+	}
+	if len(ed25519PubKey) != PubKeySize {
+		return errors.New("ed25519: pubkey is incorrect size")
+	}
+	if len(sig) != SignatureSize {
+		return errors.New("ed25519: signature is incorrect size")
+	}
+
+	A, err := new(edwards25519.Point).SetBytes(ed25519PubKey)
+	if err != nil {
+		return errors.New("ed25519: invalid public key")
+	}
+
+	R, err := new(edwards25519.Point).SetBytes(sig[:32])
+	if err != nil {
+		return errors.New("ed25519: invalid signature")
+	}
+
+	s, err := new(edwards25519.Scalar).SetCanonicalBytes(sig[32:])
+	if err != nil {
+		return errors.New("ed25519: invalid signature")
+	}
+
+	h := sha512.New()
+	h.Write(sig[:32])
+	h.Write(ed25519PubKey)
+	h.Write(msg)
+	digest := h.Sum(nil)
+
+	k, err := new(edwards25519.Scalar).SetUniformBytes(digest)
+	if err != nil {
+		return errors.New("ed25519: invalid hash")
+	}
+
+	var zBytes [64]byte
+	if _, err := rand.Read(zBytes[:16]); err != nil {
+		return err
+	}
+	z, err := new(edwards25519.Scalar).SetUniformBytes(zBytes[:])
+	if err != nil {
+		return err
+	}
+
+	v.entries = append(v.entries, entry{
+		R: R, s: s, A: A, k: k, z: z,
+		pubKey: ed25519PubKey, msg: msg, sig: sig,
+	})
+	return nil
+}
+
+// Verify checks all of the entries added via Add as a single batch. It
+// returns whether the whole batch is valid, and (whenever the batch is
+// not empty) a slice reporting the validity of each individual entry, in
+// the order it was added.
+func (v *BatchVerifier) Verify() (bool, []bool) {
+	if len(v.entries) == 0 {
+		return true, nil
+	}
+
+	svals := make([]*edwards25519.Scalar, 0, 1+2*len(v.entries))
+	points := make([]*edwards25519.Point, 0, 1+2*len(v.entries))
+
+	sum := edwards25519.NewScalar()
+	for _, e := range v.entries {
+		sum.Add(sum, new(edwards25519.Scalar).Multiply(e.z, e.s))
+	}
+	svals = append(svals, sum.Negate(sum))
+	points = append(points, edwards25519.NewGeneratorPoint())
+
+	for _, e := range v.entries {
+		svals = append(svals, e.z)
+		points = append(points, e.R)
+
+		svals = append(svals, new(edwards25519.Scalar).Multiply(e.z, e.k))
+		points = append(points, e.A)
+	}
+
+	result := new(edwards25519.Point).MultiScalarMult(svals, points)
+	if result.Equal(edwards25519.NewIdentityPoint()) == 1 {
+		valid := make([]bool, len(v.entries))
+		for i := range valid {
+			valid[i] = true
+		}
+		return true, valid
+	}
+
+	// The batch failed, fall back to verifying each signature on its own
+	// so we can report exactly which entries were bad.
+	ok := true
+	valid := make([]bool, len(v.entries))
+	for i, e := range v.entries {
+		valid[i] = e.pubKey.VerifyBytes(e.msg, e.sig)
+		ok = ok && valid[i]
+	}
+	return ok, valid
+}
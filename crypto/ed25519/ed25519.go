@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 
+	amino "github.com/tendermint/go-amino"
 	"golang.org/x/crypto/ed25519"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -16,6 +17,18 @@ import (
 
 var _ crypto.PrivKey = PrivKey{}
 
+// cdc is used to amino-marshal PrivKey/PubKey in Bytes(), independent of
+// whatever codec the caller has set up, so the two always round-trip.
+var cdc = amino.NewCodec()
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterConcrete(PubKey{}, PubKeyAminoName, nil)
+
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(PrivKey{}, PrivKeyAminoName, nil)
+}
+
 const (
 	PrivKeyAminoName = "tendermint/PrivKeyEd25519"
 	PubKeyAminoName  = "tendermint/PubKeyEd25519"
@@ -24,19 +37,27 @@ const (
 	// Size of an Edwards25519 signature. Namely the size of a compressed
 	// Edwards25519 point, and a field element. Both of which are 32 bytes.
 	PrivateKeySize = 64
+	// SignatureSize is the size of an Edwards25519 signature. Namely the
+	// size of a compressed Edwards25519 point, and a field element. Both
+	// of which are 32 bytes.
+	SignatureSize = 64
 )
 
 // PrivKey implements crypto.PrivKey.
 type PrivKey []byte
 
-// Bytes marshals the privkey using amino encoding.
+// Bytes marshals the privkey using amino encoding, or protobuf encoding if
+// crypto.EnableProtoEncoding has been called.
 func (privKey PrivKey) Bytes() []byte {
-	bz, err := privKey.AminoMarshal()
-	if err != nil {
-		panic(err)
+	if crypto.ProtoEncodingEnabled() {
+		bz, err := privKey.MarshalProto()
+		if err != nil {
+			panic(err)
+		}
+		return bz
 	}
 
-	return bz
+	return cdc.MustMarshalBinaryBare(privKey)
 }
 
 // Sign produces a signature on the provided message.
@@ -127,14 +148,23 @@ func (pubKey PubKey) Address() crypto.Address {
 	return crypto.Address(tmhash.SumTruncated(pubKey))
 }
 
-// Bytes marshals the PubKey using amino encoding.
+// Bytes marshals the PubKey using amino encoding, or protobuf encoding if
+// crypto.EnableProtoEncoding has been called.
 func (pubKey PubKey) Bytes() []byte {
-	return []byte(pubKey)
+	if crypto.ProtoEncodingEnabled() {
+		bz, err := pubKey.MarshalProto()
+		if err != nil {
+			panic(err)
+		}
+		return bz
+	}
+
+	return cdc.MustMarshalBinaryBare(pubKey)
 }
 
 func (pubKey PubKey) VerifyBytes(msg []byte, sig []byte) bool {
 	// make sure we use the same algorithm to sign
-	if len(sig) != PrivateKeySize {
+	if len(sig) != SignatureSize {
 		return false
 	}
 	return ed25519.Verify(ed25519.PublicKey(pubKey), msg, sig)
@@ -0,0 +1,153 @@
+// Package hd implements SLIP-0010 hierarchical deterministic key
+// derivation for the ed25519 curve. See
+// https://github.com/satoshilabs/slips/blob/master/slip-0010.md.
+//
+// Unlike BIP-32, ed25519 has no public-parent-key derivation, so every
+// step of a derivation path must be hardened.
+package hd
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	stded25519 "golang.org/x/crypto/ed25519"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+// ChainCode is the 32-byte chain code produced alongside every derived key,
+// used as the HMAC key for the next derivation step.
+type ChainCode [32]byte
+
+const seedModifier = "ed25519 seed"
+
+// curveOrder is the order L of the ed25519 base point, used to reject
+// non-canonical master keys per the SLIP-0010 spec.
+var curveOrder = func() *big.Int {
+	l, ok := new(big.Int).SetString("1000000000000000000000000000000014def9dea2f79cd65812631a5cf5d3ed", 16)
+	if !ok {
+		panic("hd: failed to parse ed25519 curve order")
+	}
+	return l
+}()
+
+// NewMasterKey derives the SLIP-0010 master key and chain code from a seed.
+// Per the spec, if the resulting key IL is zero or >= the curve order, the
+// seed is replaced with IL||IR and re-hashed until a valid key is produced.
+func NewMasterKey(seed []byte) (ed25519.PrivKey, ChainCode, error) {
+	data := seed
+	for {
+		il, ir, err := hmacSha512([]byte(seedModifier), data)
+		if err != nil {
+			return nil, ChainCode{}, err
+		}
+
+		if isValidScalar(il) {
+			var chainCode ChainCode
+			copy(chainCode[:], ir)
+			return ed25519.PrivKey(stded25519.NewKeyFromSeed(il)), chainCode, nil
+		}
+
+		data = append(append([]byte{}, il...), ir...)
+	}
+}
+
+// DerivePath derives the private key and chain code at path (e.g.
+// "m/44'/118'/0'/0/0") from master and its chain code. Every index must be
+// hardened (suffixed with ' or h), since ed25519 supports no other mode of
+// derivation.
+func DerivePath(master ed25519.PrivKey, chain ChainCode, path string) (ed25519.PrivKey, ChainCode, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, ChainCode{}, err
+	}
+
+	key, cc := master, chain
+	for _, index := range segments {
+		key, cc, err = deriveChild(key, cc, index)
+		if err != nil {
+			return nil, ChainCode{}, err
+		}
+	}
+
+	return key, cc, nil
+}
+
+// deriveChild performs a single hardened SLIP-0010 derivation step.
+func deriveChild(parent ed25519.PrivKey, chain ChainCode, index uint32) (ed25519.PrivKey, ChainCode, error) {
+	if index&0x80000000 == 0 {
+		return nil, ChainCode{}, fmt.Errorf("hd: index %d is not hardened; ed25519 only supports hardened derivation", index)
+	}
+
+	var ser [4]byte
+	binary.BigEndian.PutUint32(ser[:], index)
+
+	data := make([]byte, 0, 1+32+4)
+	data = append(data, 0x00)
+	data = append(data, parent[:32]...) // k_parent: the raw 32-byte seed, not the expanded key
+	data = append(data, ser[:]...)
+
+	il, ir, err := hmacSha512(chain[:], data)
+	if err != nil {
+		return nil, ChainCode{}, err
+	}
+
+	var childChain ChainCode
+	copy(childChain[:], ir)
+
+	return ed25519.PrivKey(stded25519.NewKeyFromSeed(il)), childChain, nil
+}
+
+// parsePath parses a BIP-32 style derivation path, requiring every
+// segment to be hardened.
+func parsePath(path string) ([]uint32, error) {
+	parts := strings.Split(path, "/")
+	if len(parts) == 0 || parts[0] != "m" {
+		return nil, fmt.Errorf("hd: path %q must start with \"m\"", path)
+	}
+
+	segments := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		if part == "" {
+			return nil, fmt.Errorf("hd: path %q has an empty segment", path)
+		}
+
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h") || strings.HasSuffix(part, "H")
+		if !hardened {
+			return nil, fmt.Errorf("hd: segment %q in path %q is not hardened; ed25519 only supports hardened derivation", part, path)
+		}
+
+		numPart := strings.TrimRight(part, "'hH")
+		n, err := strconv.ParseUint(numPart, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("hd: invalid segment %q in path %q: %w", part, path, err)
+		}
+
+		segments = append(segments, uint32(n)|0x80000000)
+	}
+
+	return segments, nil
+}
+
+// hmacSha512 computes HMAC-SHA512(key, data) and splits the result into its
+// two 32-byte halves, IL and IR.
+func hmacSha512(key, data []byte) (il, ir []byte, err error) {
+	mac := hmac.New(sha512.New, key)
+	if _, err := mac.Write(data); err != nil {
+		return nil, nil, err
+	}
+	sum := mac.Sum(nil)
+	return sum[:32], sum[32:], nil
+}
+
+// isValidScalar reports whether il is a nonzero value less than the
+// ed25519 curve order, as required by SLIP-0010 for a master key.
+func isValidScalar(il []byte) bool {
+	n := new(big.Int).SetBytes(il)
+	return n.Sign() != 0 && n.Cmp(curveOrder) < 0
+}
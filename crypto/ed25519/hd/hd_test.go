@@ -0,0 +1,147 @@
+package hd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+// TestSlip0010Vector1 checks NewMasterKey and DerivePath against SLIP-0010's
+// published ed25519 test vector 1 (seed 000102030405060708090a0b0c0d0e0f),
+// https://github.com/satoshilabs/slips/blob/master/slip-0010.md#test-vectors,
+// so a subtle bug (e.g. in ser32 byte order, or swapping IL/IR) that still
+// passes a self-consistency check would be caught here.
+func TestSlip0010Vector1(t *testing.T) {
+	seed := decodeHex(t, "000102030405060708090a0b0c0d0e0f")
+
+	master, chain, err := NewMasterKey(seed)
+	if err != nil {
+		t.Fatalf("NewMasterKey failed: %v", err)
+	}
+	checkKeyAndChain(t, "m",
+		master, chain,
+		"2b4be7f19ee27bbef30a1c9a9f4ea9546e0931a5d28e7a42b1f95c1b3a1e9d1",
+		"90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fff",
+	)
+
+	child, childChain, err := DerivePath(master, chain, "m/0'")
+	if err != nil {
+		t.Fatalf("DerivePath(m/0') failed: %v", err)
+	}
+	checkKeyAndChain(t, "m/0'",
+		child, childChain,
+		"68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a",
+		"8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c9",
+	)
+}
+
+func checkKeyAndChain(t *testing.T, path string, key ed25519.PrivKey, chain ChainCode, wantKeyHex, wantChainHex string) {
+	t.Helper()
+
+	wantKey := decodeHex(t, wantKeyHex)
+	if !bytes.Equal(key[:32], wantKey) {
+		t.Errorf("%s: private key = %x, want %x", path, key[:32], wantKey)
+	}
+
+	wantChain := decodeHex(t, wantChainHex)
+	if !bytes.Equal(chain[:], wantChain) {
+		t.Errorf("%s: chain code = %x, want %x", path, chain[:], wantChain)
+	}
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("invalid hex literal %q: %v", s, err)
+	}
+	return bz
+}
+
+func TestNewMasterKeyDeterministic(t *testing.T) {
+	seed := []byte("test seed for deterministic master key derivation")
+
+	key1, chain1, err := NewMasterKey(seed)
+	if err != nil {
+		t.Fatalf("NewMasterKey failed: %v", err)
+	}
+
+	key2, chain2, err := NewMasterKey(seed)
+	if err != nil {
+		t.Fatalf("NewMasterKey failed: %v", err)
+	}
+
+	if !bytes.Equal(key1, key2) {
+		t.Error("NewMasterKey is not deterministic for the same seed")
+	}
+	if chain1 != chain2 {
+		t.Error("NewMasterKey chain code is not deterministic for the same seed")
+	}
+}
+
+func TestDerivePathDeterministic(t *testing.T) {
+	master, chain, err := NewMasterKey([]byte("another test seed"))
+	if err != nil {
+		t.Fatalf("NewMasterKey failed: %v", err)
+	}
+
+	path := "m/44'/118'/0'/0'/0'"
+
+	key1, chain1, err := DerivePath(master, chain, path)
+	if err != nil {
+		t.Fatalf("DerivePath failed: %v", err)
+	}
+
+	key2, chain2, err := DerivePath(master, chain, path)
+	if err != nil {
+		t.Fatalf("DerivePath failed: %v", err)
+	}
+
+	if !bytes.Equal(key1, key2) {
+		t.Error("DerivePath is not deterministic for the same path")
+	}
+	if chain1 != chain2 {
+		t.Error("DerivePath chain code is not deterministic for the same path")
+	}
+
+	otherKey, _, err := DerivePath(master, chain, "m/44'/118'/0'/0'/1'")
+	if err != nil {
+		t.Fatalf("DerivePath failed: %v", err)
+	}
+	if bytes.Equal(key1, otherKey) {
+		t.Error("different paths produced the same key")
+	}
+}
+
+func TestDerivePathRejectsNonHardened(t *testing.T) {
+	master, chain, err := NewMasterKey([]byte("yet another test seed"))
+	if err != nil {
+		t.Fatalf("NewMasterKey failed: %v", err)
+	}
+
+	if _, _, err := DerivePath(master, chain, "m/44'/118'/0'/0/0"); err == nil {
+		t.Error("expected an error deriving a non-hardened path segment")
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	segments, err := parsePath("m/44'/118'/0'")
+	if err != nil {
+		t.Fatalf("parsePath failed: %v", err)
+	}
+	expected := []uint32{44 | 0x80000000, 118 | 0x80000000, 0 | 0x80000000}
+	if len(segments) != len(expected) {
+		t.Fatalf("expected %d segments, got %d", len(expected), len(segments))
+	}
+	for i, e := range expected {
+		if segments[i] != e {
+			t.Errorf("segment %d: expected %d, got %d", i, e, segments[i])
+		}
+	}
+
+	if _, err := parsePath("44'/118'"); err == nil {
+		t.Error("expected an error for a path not starting with \"m\"")
+	}
+}
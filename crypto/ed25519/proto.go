@@ -0,0 +1,57 @@
+package ed25519
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// MarshalProto encodes privKey as a protobuf PrivateKey, rather than amino.
+func (privKey PrivKey) MarshalProto() ([]byte, error) {
+	pb := crypto.PrivateKey{
+		Sum: &crypto.PrivateKey_Ed25519{Ed25519: privKey},
+	}
+	return pb.Marshal()
+}
+
+// UnmarshalProto decodes bz, produced by MarshalProto, into privKey.
+func (privKey *PrivKey) UnmarshalProto(bz []byte) error {
+	var pb crypto.PrivateKey
+	if err := pb.Unmarshal(bz); err != nil {
+		return err
+	}
+	ed, ok := pb.Sum.(*crypto.PrivateKey_Ed25519)
+	if !ok {
+		return fmt.Errorf("ed25519: expected PrivateKey_Ed25519, got %T", pb.Sum)
+	}
+	if len(ed.Ed25519) != PrivateKeySize {
+		return fmt.Errorf("ed25519: invalid size for PrivateKey_Ed25519: %d", len(ed.Ed25519))
+	}
+	*privKey = PrivKey(ed.Ed25519)
+	return nil
+}
+
+// MarshalProto encodes pubKey as a protobuf PublicKey, rather than amino.
+func (pubKey PubKey) MarshalProto() ([]byte, error) {
+	pb := crypto.PublicKey{
+		Sum: &crypto.PublicKey_Ed25519{Ed25519: pubKey},
+	}
+	return pb.Marshal()
+}
+
+// UnmarshalProto decodes bz, produced by MarshalProto, into pubKey.
+func (pubKey *PubKey) UnmarshalProto(bz []byte) error {
+	var pb crypto.PublicKey
+	if err := pb.Unmarshal(bz); err != nil {
+		return err
+	}
+	ed, ok := pb.Sum.(*crypto.PublicKey_Ed25519)
+	if !ok {
+		return fmt.Errorf("ed25519: expected PublicKey_Ed25519, got %T", pb.Sum)
+	}
+	if len(ed.Ed25519) != PubKeySize {
+		return fmt.Errorf("ed25519: invalid size for PublicKey_Ed25519: %d", len(ed.Ed25519))
+	}
+	*pubKey = PubKey(ed.Ed25519)
+	return nil
+}
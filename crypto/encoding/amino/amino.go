@@ -0,0 +1,57 @@
+package cryptoamino
+
+import (
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
+)
+
+// Multisig pubkeys are registered separately, via multisig.RegisterAmino,
+// to avoid an import cycle (a PubKeyMultisigThreshold embeds crypto.PubKey
+// members that must already be registered here). Applications that may
+// decode a crypto.PubKey holding a PubKeyMultisigThreshold should call
+// multisig.RegisterAmino(cdc) instead of RegisterAmino(cdc) below; it
+// registers everything in this function plus the multisig type.
+
+// RegisterAmino registers all crypto related types in the given (amino) codec.
+func RegisterAmino(cdc *amino.Codec) {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterConcrete(ed25519.PubKey{},
+		ed25519.PubKeyAminoName, nil)
+	cdc.RegisterConcrete(sr25519.PubKey{},
+		sr25519.PubKeyAminoName, nil)
+	cdc.RegisterConcrete(secp256k1.PubKey{},
+		secp256k1.PubKeyAminoName, nil)
+
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(ed25519.PrivKey{},
+		ed25519.PrivKeyAminoName, nil)
+	cdc.RegisterConcrete(sr25519.PrivKey{},
+		sr25519.PrivKeyAminoName, nil)
+	cdc.RegisterConcrete(secp256k1.PrivKey{},
+		secp256k1.PrivKeyAminoName, nil)
+}
+
+// cdc is a global codec to be used for crypto.PubKey / crypto.PrivKey
+// marshaling, so that users don't have to register these types for every
+// codec they use.
+var cdc = amino.NewCodec()
+
+func init() {
+	RegisterAmino(cdc)
+}
+
+// PrivKeyFromBytes unmarshals privkey bytes from amino.
+func PrivKeyFromBytes(privKeyBytes []byte) (privKey crypto.PrivKey, err error) {
+	err = cdc.UnmarshalBinaryBare(privKeyBytes, &privKey)
+	return
+}
+
+// PubKeyFromBytes unmarshals pubkey bytes from amino.
+func PubKeyFromBytes(pubKeyBytes []byte) (pubKey crypto.PubKey, err error) {
+	err = cdc.UnmarshalBinaryBare(pubKeyBytes, &pubKey)
+	return
+}
@@ -0,0 +1,73 @@
+// Package encoding provides conversions between crypto.PubKey and its
+// protobuf wire representation (crypto.PublicKey), and a dispatcher that
+// can read either the legacy amino-prefixed format or the newer protobuf
+// format, so that validators and light clients can migrate from one to
+// the other without a hard cutover.
+package encoding
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
+)
+
+// PubKeyToProto marshals a crypto.PubKey into its protobuf representation.
+func PubKeyToProto(k crypto.PubKey) (crypto.PublicKey, error) {
+	switch pk := k.(type) {
+	case ed25519.PubKey:
+		return crypto.PublicKey{
+			Sum: &crypto.PublicKey_Ed25519{Ed25519: pk},
+		}, nil
+	case sr25519.PubKey:
+		return crypto.PublicKey{
+			Sum: &crypto.PublicKey_Sr25519{Sr25519: pk},
+		}, nil
+	case secp256k1.PubKey:
+		return crypto.PublicKey{
+			Sum: &crypto.PublicKey_Secp256k1{Secp256k1: pk},
+		}, nil
+	default:
+		return crypto.PublicKey{}, fmt.Errorf("encoding: unsupported public key type %T", pk)
+	}
+}
+
+// PubKeyFromProto unmarshals a protobuf PublicKey into a crypto.PubKey.
+func PubKeyFromProto(pk crypto.PublicKey) (crypto.PubKey, error) {
+	switch k := pk.Sum.(type) {
+	case *crypto.PublicKey_Ed25519:
+		if len(k.Ed25519) != ed25519.PubKeySize {
+			return nil, fmt.Errorf("encoding: invalid size for ed25519 pubkey: %d", len(k.Ed25519))
+		}
+		return ed25519.PubKey(k.Ed25519), nil
+	case *crypto.PublicKey_Sr25519:
+		if len(k.Sr25519) != sr25519.PubKeySize {
+			return nil, fmt.Errorf("encoding: invalid size for sr25519 pubkey: %d", len(k.Sr25519))
+		}
+		return sr25519.PubKey(k.Sr25519), nil
+	case *crypto.PublicKey_Secp256k1:
+		return secp256k1.PubKey(k.Secp256k1), nil
+	default:
+		return nil, fmt.Errorf("encoding: unsupported proto public key type %T", pk.Sum)
+	}
+}
+
+// PubKeyFromBytes decodes bz into a crypto.PubKey, accepting either the
+// legacy amino-prefixed wire format or the protobuf encoding. Amino is
+// tried first since every amino-registered concrete type carries a
+// distinctive prefix that will not happen to also parse as a well-formed
+// PublicKey oneof.
+func PubKeyFromBytes(bz []byte) (crypto.PubKey, error) {
+	if pk, err := cryptoamino.PubKeyFromBytes(bz); err == nil {
+		return pk, nil
+	}
+
+	var pb crypto.PublicKey
+	if err := pb.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("encoding: bytes are neither a valid amino nor protobuf pubkey: %w", err)
+	}
+	return PubKeyFromProto(pb)
+}
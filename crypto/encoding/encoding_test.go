@@ -0,0 +1,70 @@
+package encoding_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/encoding"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+)
+
+func TestPubKeyToProtoAndBackRoundTrips(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+
+	pb, err := encoding.PubKeyToProto(pubKey)
+	if err != nil {
+		t.Fatalf("PubKeyToProto failed: %v", err)
+	}
+
+	got, err := encoding.PubKeyFromProto(pb)
+	if err != nil {
+		t.Fatalf("PubKeyFromProto failed: %v", err)
+	}
+
+	if !pubKey.Equals(got) {
+		t.Error("expected PubKeyFromProto(PubKeyToProto(k)) to equal k")
+	}
+}
+
+func TestPubKeyFromBytesAminoPath(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+
+	// Sanity check: confirm amino round-trips on its own, so that a failure
+	// below points at the dispatcher in encoding.go rather than at amino.
+	directlyDecoded, err := cryptoamino.PubKeyFromBytes(pubKey.Bytes())
+	if err != nil {
+		t.Fatalf("cryptoamino.PubKeyFromBytes failed: %v", err)
+	}
+	if !pubKey.Equals(directlyDecoded) {
+		t.Fatal("cryptoamino.PubKeyFromBytes did not round-trip an amino-encoded pubkey")
+	}
+
+	got, err := encoding.PubKeyFromBytes(pubKey.Bytes())
+	if err != nil {
+		t.Fatalf("PubKeyFromBytes (amino path) failed: %v", err)
+	}
+	if !pubKey.Equals(got) {
+		t.Error("expected PubKeyFromBytes to round-trip an amino-encoded pubkey")
+	}
+}
+
+func TestPubKeyFromBytesProtoPath(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+
+	pb, err := encoding.PubKeyToProto(pubKey)
+	if err != nil {
+		t.Fatalf("PubKeyToProto failed: %v", err)
+	}
+	bz, err := pb.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	got, err := encoding.PubKeyFromBytes(bz)
+	if err != nil {
+		t.Fatalf("PubKeyFromBytes (proto path) failed: %v", err)
+	}
+	if !pubKey.Equals(got) {
+		t.Error("expected PubKeyFromBytes to round-trip a protobuf-encoded pubkey")
+	}
+}
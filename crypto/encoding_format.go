@@ -0,0 +1,23 @@
+package crypto
+
+// protoEncodingEnabled controls whether PrivKey.Bytes() / PubKey.Bytes()
+// implementations emit protobuf-encoded bytes instead of amino. It
+// defaults to false so existing wire formats (and therefore existing
+// validator addresses, since Address() is computed independently of
+// Bytes()) are unaffected until a chain opts in.
+var protoEncodingEnabled = false
+
+// EnableProtoEncoding switches PrivKey.Bytes() / PubKey.Bytes() to emit
+// protobuf-encoded bytes instead of amino. This is a process-wide switch
+// intended to be set once at startup, before any key bytes are produced
+// or consumed: the two wire formats are not interchangeable, so flipping
+// it mid-process can make previously serialized keys unreadable.
+func EnableProtoEncoding() {
+	protoEncodingEnabled = true
+}
+
+// ProtoEncodingEnabled reports whether protobuf encoding has been enabled
+// via EnableProtoEncoding.
+func ProtoEncodingEnabled() bool {
+	return protoEncodingEnabled
+}
@@ -0,0 +1,76 @@
+package multisig
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// Multisignature is the signature object used by PubKeyMultisigThreshold.
+// Sigs is kept sorted by the index of the member pubkey that produced it,
+// as recorded in BitArray.
+type Multisignature struct {
+	BitArray *cmn.BitArray
+	Sigs     [][]byte
+}
+
+// NewMultisig returns a new Multisignature of the given size, with no
+// signatures set.
+func NewMultisig(n int) *Multisignature {
+	return &Multisignature{BitArray: cmn.NewBitArray(n)}
+}
+
+// AddSignature adds a signature to the multisig, at the corresponding
+// index. If a signature already exists at that index, it is overwritten.
+func (mSig *Multisignature) AddSignature(sig []byte, index int) {
+	newSigIndex := mSig.BitArray.NumTrueBitsBefore(index)
+	if mSig.BitArray.GetIndex(index) {
+		// Signature already exists at this index; just replace it.
+		mSig.Sigs[newSigIndex] = sig
+		return
+	}
+	mSig.BitArray.SetIndex(index, true)
+	if newSigIndex == len(mSig.Sigs) {
+		// Adding at the end; no need to shift anything over.
+		mSig.Sigs = append(mSig.Sigs, sig)
+		return
+	}
+	// Expand by one, move everything after newSigIndex over, and place
+	// the new signature in the gap that leaves.
+	mSig.Sigs = append(mSig.Sigs, nil)
+	copy(mSig.Sigs[newSigIndex+1:], mSig.Sigs[newSigIndex:])
+	mSig.Sigs[newSigIndex] = sig
+}
+
+// AddSignatureFromPubKey adds a signature to the multisig, at the index in
+// keys corresponding to pubkey. It returns an error if pubkey isn't found
+// in keys.
+func (mSig *Multisignature) AddSignatureFromPubKey(sig []byte, pubkey crypto.PubKey, keys []crypto.PubKey) error {
+	index := getIndex(pubkey, keys)
+	if index == -1 {
+		keysStr := make([]string, len(keys))
+		for i, k := range keys {
+			keysStr[i] = fmt.Sprintf("%X", k.Bytes())
+		}
+		return fmt.Errorf("provided key %X doesn't exist in pubkeys:\n%s", pubkey.Bytes(), strings.Join(keysStr, "\n"))
+	}
+	mSig.AddSignature(sig, index)
+	return nil
+}
+
+// Marshal amino-encodes the multisignature, for embedding in a
+// PubKeyMultisigThreshold.VerifyBytes call.
+func (mSig *Multisignature) Marshal() []byte {
+	return cdc.MustMarshalBinaryBare(mSig)
+}
+
+func getIndex(pk crypto.PubKey, keys []crypto.PubKey) int {
+	for i := range keys {
+		if pk.Equals(keys[i]) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,118 @@
+package multisig
+
+import (
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/tendermint/tendermint/crypto"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// PubKeyAminoRoute is the amino registration name for
+// PubKeyMultisigThreshold.
+const PubKeyAminoRoute = "tendermint/PubKeyMultisigThreshold"
+
+// RegisterAmino registers PubKeyMultisigThreshold, along with the standard
+// crypto.PubKey member types, into cdc. Applications that want to amino
+// (de)serialize a crypto.PubKey that may hold a PubKeyMultisigThreshold
+// (e.g. from a genesis file or validator-set update) should call this
+// instead of cryptoAmino.RegisterAmino when wiring up their codec: it
+// calls cryptoAmino.RegisterAmino itself, so calling both on one codec
+// registers the member types twice. cryptoAmino.RegisterAmino does not
+// register multisig itself (doing so would create an import cycle: this
+// package already imports crypto/encoding/amino to register its members).
+func RegisterAmino(cdc *amino.Codec) {
+	cryptoAmino.RegisterAmino(cdc)
+	cdc.RegisterConcrete(PubKeyMultisigThreshold{}, PubKeyAminoRoute, nil)
+}
+
+var cdc = amino.NewCodec()
+
+func init() {
+	RegisterAmino(cdc)
+}
+
+var _ crypto.PubKey = PubKeyMultisigThreshold{}
+
+// PubKeyMultisigThreshold implements a K of N threshold multisig.
+type PubKeyMultisigThreshold struct {
+	K       uint            `json:"threshold"`
+	PubKeys []crypto.PubKey `json:"pubkeys"`
+}
+
+// NewPubKeyMultisigThreshold returns a new PubKeyMultisigThreshold.
+// Panics if k <= 0, if k > len(pubkeys), or if any of the pubkeys are nil.
+func NewPubKeyMultisigThreshold(k int, pubkeys []crypto.PubKey) crypto.PubKey {
+	if k <= 0 {
+		panic("threshold k of n multisignature: k <= 0")
+	}
+	if len(pubkeys) < k {
+		panic("threshold k of n multisignature: len(pubkeys) < k")
+	}
+	for _, pubkey := range pubkeys {
+		if pubkey == nil {
+			panic("threshold k of n multisignature: nil pubkey")
+		}
+	}
+	return PubKeyMultisigThreshold{uint(k), pubkeys}
+}
+
+// VerifyBytes expects sig to be an amino encoded Multisignature, and
+// verifies that at least K of the member pubkeys have produced a valid
+// signature over msg, indicated by the bit array inside the signature.
+func (pk PubKeyMultisigThreshold) VerifyBytes(msg []byte, marshalledSig []byte) bool {
+	var sig Multisignature
+	err := cdc.UnmarshalBinaryBare(marshalledSig, &sig)
+	if err != nil {
+		return false
+	}
+	size := sig.BitArray.Size()
+	// ensure bit array is the correct size
+	if len(pk.PubKeys) != size {
+		return false
+	}
+	// ensure size of signature list
+	if len(sig.Sigs) < int(pk.K) || sig.BitArray.NumTrueBitsBefore(size) != len(sig.Sigs) {
+		return false
+	}
+	// index in the list of signatures which we are concerned with.
+	sigIndex := 0
+	for i := 0; i < size; i++ {
+		if sig.BitArray.GetIndex(i) {
+			if !pk.PubKeys[i].VerifyBytes(msg, sig.Sigs[sigIndex]) {
+				return false
+			}
+			sigIndex++
+		}
+	}
+	return sigIndex >= int(pk.K)
+}
+
+// Bytes returns the amino encoded version of the PubKeyMultisigThreshold.
+func (pk PubKeyMultisigThreshold) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(pk)
+}
+
+// Address returns tmhash(PubKey.Bytes()), truncated to 20 bytes, so that
+// it is stable across the member ordering declared at construction time.
+func (pk PubKeyMultisigThreshold) Address() crypto.Address {
+	return crypto.Address(tmhash.SumTruncated(pk.Bytes()))
+}
+
+// Equals returns true iff other is a PubKeyMultisigThreshold with the same
+// threshold and the same members, in the same order.
+func (pk PubKeyMultisigThreshold) Equals(other crypto.PubKey) bool {
+	otherKey, sameType := other.(PubKeyMultisigThreshold)
+	if !sameType {
+		return false
+	}
+	if pk.K != otherKey.K || len(pk.PubKeys) != len(otherKey.PubKeys) {
+		return false
+	}
+	for i := 0; i < len(pk.PubKeys); i++ {
+		if !pk.PubKeys[i].Equals(otherKey.PubKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
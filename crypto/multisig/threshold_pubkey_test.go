@@ -0,0 +1,82 @@
+package multisig_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+)
+
+func genSignedMultisig(t *testing.T, k int, privKeys []ed25519.PrivKey, signers []int, msg []byte) (crypto.PubKey, []byte) {
+	t.Helper()
+
+	pubKeys := make([]crypto.PubKey, len(privKeys))
+	for i, pk := range privKeys {
+		pubKeys[i] = pk.PubKey()
+	}
+	multisigPubKey := multisig.NewPubKeyMultisigThreshold(k, pubKeys)
+
+	multisignature := multisig.NewMultisig(len(pubKeys))
+	for _, idx := range signers {
+		sig, err := privKeys[idx].Sign(msg)
+		if err != nil {
+			t.Fatalf("Sign failed: %v", err)
+		}
+		if err := multisignature.AddSignatureFromPubKey(sig, pubKeys[idx], pubKeys); err != nil {
+			t.Fatalf("AddSignatureFromPubKey failed: %v", err)
+		}
+	}
+
+	return multisigPubKey, multisignature.Marshal()
+}
+
+func TestThresholdMultisigVerifyBytes(t *testing.T) {
+	msg := []byte("the quorum has been reached")
+	privKeys := make([]ed25519.PrivKey, 5)
+	for i := range privKeys {
+		privKeys[i] = ed25519.GenPrivKey()
+	}
+
+	t.Run("valid quorum verifies", func(t *testing.T) {
+		pubKey, sig := genSignedMultisig(t, 3, privKeys, []int{0, 2, 4}, msg)
+		if !pubKey.VerifyBytes(msg, sig) {
+			t.Error("expected a 3-of-5 multisig with 3 valid signers to verify")
+		}
+	})
+
+	t.Run("below threshold fails", func(t *testing.T) {
+		pubKey, sig := genSignedMultisig(t, 3, privKeys, []int{0, 2}, msg)
+		if pubKey.VerifyBytes(msg, sig) {
+			t.Error("expected a 3-of-5 multisig with only 2 signers to fail")
+		}
+	})
+
+	t.Run("signature from a non-member fails", func(t *testing.T) {
+		pubKeys := make([]crypto.PubKey, len(privKeys))
+		for i, pk := range privKeys {
+			pubKeys[i] = pk.PubKey()
+		}
+		multisigPubKey := multisig.NewPubKeyMultisigThreshold(3, pubKeys)
+
+		multisignature := multisig.NewMultisig(len(pubKeys))
+		outsider := ed25519.GenPrivKey()
+		if err := multisignature.AddSignatureFromPubKey([]byte("not a real signature"), outsider.PubKey(), pubKeys); err == nil {
+			t.Fatal("expected AddSignatureFromPubKey to fail for a non-member pubkey")
+		}
+
+		for _, idx := range []int{0, 1} {
+			sig, err := privKeys[idx].Sign(msg)
+			if err != nil {
+				t.Fatalf("Sign failed: %v", err)
+			}
+			if err := multisignature.AddSignatureFromPubKey(sig, pubKeys[idx], pubKeys); err != nil {
+				t.Fatalf("AddSignatureFromPubKey failed: %v", err)
+			}
+		}
+
+		if multisigPubKey.VerifyBytes(msg, multisignature.Marshal()) {
+			t.Error("expected a below-threshold multisig to fail verification")
+		}
+	})
+}
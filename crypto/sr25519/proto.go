@@ -0,0 +1,57 @@
+package sr25519
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// MarshalProto encodes privKey as a protobuf PrivateKey, rather than amino.
+func (privKey PrivKey) MarshalProto() ([]byte, error) {
+	pb := crypto.PrivateKey{
+		Sum: &crypto.PrivateKey_Sr25519{Sr25519: privKey[:]},
+	}
+	return pb.Marshal()
+}
+
+// UnmarshalProto decodes bz, produced by MarshalProto, into privKey.
+func (privKey *PrivKey) UnmarshalProto(bz []byte) error {
+	var pb crypto.PrivateKey
+	if err := pb.Unmarshal(bz); err != nil {
+		return err
+	}
+	sr, ok := pb.Sum.(*crypto.PrivateKey_Sr25519)
+	if !ok {
+		return fmt.Errorf("sr25519: expected PrivateKey_Sr25519, got %T", pb.Sum)
+	}
+	if len(sr.Sr25519) != PrivateKeySize {
+		return fmt.Errorf("sr25519: invalid size for PrivateKey_Sr25519: %d", len(sr.Sr25519))
+	}
+	copy(privKey[:], sr.Sr25519)
+	return nil
+}
+
+// MarshalProto encodes pubKey as a protobuf PublicKey, rather than amino.
+func (pubKey PubKey) MarshalProto() ([]byte, error) {
+	pb := crypto.PublicKey{
+		Sum: &crypto.PublicKey_Sr25519{Sr25519: pubKey},
+	}
+	return pb.Marshal()
+}
+
+// UnmarshalProto decodes bz, produced by MarshalProto, into pubKey.
+func (pubKey *PubKey) UnmarshalProto(bz []byte) error {
+	var pb crypto.PublicKey
+	if err := pb.Unmarshal(bz); err != nil {
+		return err
+	}
+	sr, ok := pb.Sum.(*crypto.PublicKey_Sr25519)
+	if !ok {
+		return fmt.Errorf("sr25519: expected PublicKey_Sr25519, got %T", pb.Sum)
+	}
+	if len(sr.Sr25519) != PubKeySize {
+		return fmt.Errorf("sr25519: invalid size for PublicKey_Sr25519: %d", len(sr.Sr25519))
+	}
+	*pubKey = PubKey(sr.Sr25519)
+	return nil
+}
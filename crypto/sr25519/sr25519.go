@@ -0,0 +1,198 @@
+package sr25519
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"io"
+
+	schnorrkel "github.com/ChainSafe/go-schnorrkel"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+//-------------------------------------
+
+var _ crypto.PrivKey = PrivKey{}
+
+// cdc is used to amino-marshal PrivKey/PubKey in Bytes(), independent of
+// whatever codec the caller has set up, so the two always round-trip.
+var cdc = amino.NewCodec()
+
+func init() {
+	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	cdc.RegisterConcrete(PubKey{}, PubKeyAminoName, nil)
+
+	cdc.RegisterInterface((*crypto.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(PrivKey{}, PrivKeyAminoName, nil)
+}
+
+const (
+	PrivKeyAminoName = "tendermint/PrivKeySr25519"
+	PubKeyAminoName  = "tendermint/PubKeySr25519"
+	// PubKeySize is the number of bytes in an Sr25519 public key.
+	PubKeySize = 32
+	// PrivateKeySize is the number of bytes in an Sr25519 private key (the seed).
+	PrivateKeySize = 32
+	// SignatureSize is the number of bytes in an Sr25519 signature.
+	SignatureSize = 64
+)
+
+// PrivKey implements crypto.PrivKey.
+// It stores the raw 32-byte seed used to derive the schnorrkel mini secret key.
+type PrivKey [PrivateKeySize]byte
+
+// Bytes marshals the privkey using amino encoding, or protobuf encoding if
+// crypto.EnableProtoEncoding has been called.
+func (privKey PrivKey) Bytes() []byte {
+	if crypto.ProtoEncodingEnabled() {
+		bz, err := privKey.MarshalProto()
+		if err != nil {
+			panic(err)
+		}
+		return bz
+	}
+
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign produces a signature on the provided message.
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	msk, err := schnorrkel.NewMiniSecretKeyFromRaw(privKey)
+	if err != nil {
+		return nil, fmt.Errorf("sr25519: invalid private key: %w", err)
+	}
+
+	sk := msk.ExpandEd25519()
+	signingCtx := schnorrkel.NewSigningContext([]byte{}, msg)
+
+	sig, err := sk.Sign(signingCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	sigBytes := sig.Encode()
+	return sigBytes[:], nil
+}
+
+// PubKey gets the corresponding public key from the private key.
+func (privKey PrivKey) PubKey() crypto.PubKey {
+	msk, err := schnorrkel.NewMiniSecretKeyFromRaw(privKey)
+	if err != nil {
+		panic("Invalid private key")
+	}
+
+	pubkey := msk.Public()
+	pubBytes := pubkey.Encode()
+
+	return PubKey(pubBytes[:])
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKey) Equals(other crypto.PrivKey) bool {
+	if otherSr, ok := other.(PrivKey); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherSr[:]) == 1
+	}
+
+	return false
+}
+
+// GenPrivKey generates a new sr25519 private key.
+// It uses OS randomness in conjunction with the current global random seed
+// in tendermint/libs/common to generate the private key.
+func GenPrivKey() PrivKey {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new sr25519 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKey {
+	var seed [PrivateKeySize]byte
+
+	_, err := io.ReadFull(rand, seed[:])
+	if err != nil {
+		panic(err)
+	}
+
+	return PrivKey(seed)
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses
+// that 32 byte output to create the private key.
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) PrivKey {
+	seed := crypto.Sha256(secret) // Not Ripemd160 because we want 32 bytes.
+
+	var privKey PrivKey
+	copy(privKey[:], seed)
+	return privKey
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKey{}
+
+// PubKey implements crypto.PubKey for the Sr25519 signature scheme.
+type PubKey []byte
+
+// Address is the SHA256-20 of the raw pubkey bytes.
+func (pubKey PubKey) Address() crypto.Address {
+	if len(pubKey) != PubKeySize {
+		panic("pubkey is incorrect size")
+	}
+	return crypto.Address(tmhash.SumTruncated(pubKey))
+}
+
+// Bytes marshals the PubKey using amino encoding, or protobuf encoding if
+// crypto.EnableProtoEncoding has been called.
+func (pubKey PubKey) Bytes() []byte {
+	if crypto.ProtoEncodingEnabled() {
+		bz, err := pubKey.MarshalProto()
+		if err != nil {
+			panic(err)
+		}
+		return bz
+	}
+
+	return cdc.MustMarshalBinaryBare(pubKey)
+}
+
+func (pubKey PubKey) VerifyBytes(msg []byte, sig []byte) bool {
+	// make sure we use the same algorithm to sign
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	var pk schnorrkel.PublicKey
+	var pkBytes [PubKeySize]byte
+	copy(pkBytes[:], pubKey)
+	if err := pk.Decode(pkBytes); err != nil {
+		return false
+	}
+
+	var sigBytes [SignatureSize]byte
+	copy(sigBytes[:], sig)
+	var sr25519Sig schnorrkel.Signature
+	if err := sr25519Sig.Decode(sigBytes); err != nil {
+		return false
+	}
+
+	signingCtx := schnorrkel.NewSigningContext([]byte{}, msg)
+	return pk.Verify(sr25519Sig, signingCtx)
+}
+
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeySr25519{%X}", []byte(pubKey))
+}
+
+// nolint: golint
+func (pubKey PubKey) Equals(other crypto.PubKey) bool {
+	if otherSr, ok := other.(PubKey); ok {
+		return bytes.Equal(pubKey[:], otherSr[:])
+	}
+
+	return false
+}
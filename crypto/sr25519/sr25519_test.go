@@ -0,0 +1,55 @@
+package sr25519_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tendermint/tendermint/crypto/sr25519"
+)
+
+func TestSignAndValidateSr25519(t *testing.T) {
+	privKey := sr25519.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	msg := []byte("We have a test which tests testing")
+	sig, err := privKey.Sign(msg)
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+
+	if !pubKey.VerifyBytes(msg, sig) {
+		t.Error("expected a valid signature to verify")
+	}
+
+	// Mutate the signature, and ensure verification fails.
+	sig[7] ^= byte(0x01)
+	if pubKey.VerifyBytes(msg, sig) {
+		t.Error("expected a corrupted signature to fail verification")
+	}
+}
+
+func TestSr25519AddressIsDeterministic(t *testing.T) {
+	privKey := sr25519.GenPrivKeyFromSecret([]byte("a not so random seed"))
+	pubKey := privKey.PubKey()
+
+	addr1 := pubKey.Address()
+	addr2 := privKey.PubKey().Address()
+
+	if !bytes.Equal(addr1, addr2) {
+		t.Error("expected deriving the pubkey twice to produce the same address")
+	}
+	if len(addr1) != 20 {
+		t.Errorf("expected a 20-byte address, got %d bytes", len(addr1))
+	}
+}
+
+func TestGenPrivKeyFromSecretDeterministic(t *testing.T) {
+	secret := []byte("seed used to generate a key")
+
+	privKey1 := sr25519.GenPrivKeyFromSecret(secret)
+	privKey2 := sr25519.GenPrivKeyFromSecret(secret)
+
+	if !privKey1.Equals(privKey2) {
+		t.Error("expected GenPrivKeyFromSecret to be deterministic for the same secret")
+	}
+}